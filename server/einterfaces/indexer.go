@@ -0,0 +1,48 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package einterfaces
+
+import (
+	"github.com/mattermost/mattermost/server/public/model"
+)
+
+// IndexerProgress describes the state of a long-running reindex job so that
+// it can report progress and resume from where it left off if interrupted.
+type IndexerProgress struct {
+	Done     bool
+	Cursor   string
+	StartAt  int64
+	LastAt   int64
+	NumDone  int64
+	NumTotal int64
+}
+
+// IndexerInterface is the engine-neutral indexing surface embedded by SearchEngine. It is
+// responsible for keeping a search index in sync with the store, both incrementally as content
+// changes and in bulk during a full reindex, regardless of which engine backs it.
+//
+// NOTE: this tree has no app/jobs layer yet, so nothing drives MakeWorker() or the bulk/entity
+// methods below through a scheduled job. Once that layer exists, its worker construction should
+// go through this interface rather than only `model.Worker`.
+type IndexerInterface interface {
+	MakeWorker() model.Worker
+
+	IndexPost(post *model.Post, teamID string) *model.AppError
+	DeletePost(post *model.Post) *model.AppError
+	IndexUser(user *model.User, teamsIds, channelsIds []string) *model.AppError
+	DeleteUser(user *model.User) *model.AppError
+	IndexChannel(channel *model.Channel, userIDs, teamMemberIDs []string) *model.AppError
+	DeleteChannel(channel *model.Channel) *model.AppError
+	IndexFile(file *model.FileInfo, channelID string) *model.AppError
+	DeleteFile(fileID string) *model.AppError
+
+	BulkIndexPosts(posts []*model.Post, progress IndexerProgress) (IndexerProgress, *model.AppError)
+	BulkIndexUsers(users []*model.User, progress IndexerProgress) (IndexerProgress, *model.AppError)
+	BulkIndexChannels(channels []*model.Channel, progress IndexerProgress) (IndexerProgress, *model.AppError)
+	BulkIndexFiles(files []*model.FileInfo, progress IndexerProgress) (IndexerProgress, *model.AppError)
+	BulkDelete(entityType string, ids []string) *model.AppError
+
+	PurgeIndex(entityType string) *model.AppError
+	RefreshIndexes() *model.AppError
+}
@@ -0,0 +1,44 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package einterfaces
+
+import (
+	"context"
+
+	"github.com/mattermost/mattermost/server/public/model"
+)
+
+// SearchEngine is implemented by each pluggable full-text search backend (Elasticsearch,
+// OpenSearch, Bleve, ...). It embeds IndexerInterface for the entity-typed/bulk indexing surface
+// and adds the search and lifecycle methods that are specific to running an engine rather than
+// just feeding it documents.
+type SearchEngine interface {
+	IndexerInterface
+
+	SearchPosts(channels []*model.Channel, searchParams []*model.SearchParams, page, perPage int) ([]string, model.PostSearchMatches, *model.AppError)
+	TestConfig(cfg *model.Config) *model.AppError
+	HealthCheck(ctx context.Context) error
+}
+
+// SearchEngineFactory constructs a SearchEngine from the server configuration. Engines register a
+// factory under their name via RegisterSearchEngine and are looked up by the name passed to
+// NewSearchEngine; nothing in this package reads the engine name from the configuration.
+type SearchEngineFactory func(cfg *model.Config) SearchEngine
+
+var searchEngineFactories = map[string]SearchEngineFactory{}
+
+// RegisterSearchEngine makes a SearchEngine factory available under name.
+func RegisterSearchEngine(name string, factory SearchEngineFactory) {
+	searchEngineFactories[name] = factory
+}
+
+// NewSearchEngine constructs the SearchEngine registered under name, if any. ok is false if no
+// engine has been registered under that name.
+func NewSearchEngine(name string, cfg *model.Config) (engine SearchEngine, ok bool) {
+	factory, ok := searchEngineFactories[name]
+	if !ok {
+		return nil, false
+	}
+	return factory(cfg), true
+}
@@ -0,0 +1,40 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package einterfaces
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost/server/public/model"
+)
+
+func TestRegisterSearchEngine(t *testing.T) {
+	t.Run("looks up a registered engine by name", func(t *testing.T) {
+		stub := &stubSearchEngine{}
+		RegisterSearchEngine("stub", func(cfg *model.Config) SearchEngine {
+			return stub
+		})
+
+		engine, ok := NewSearchEngine("stub", &model.Config{})
+
+		require.True(t, ok)
+		assert.Same(t, stub, engine)
+	})
+
+	t.Run("unknown name returns ok=false", func(t *testing.T) {
+		engine, ok := NewSearchEngine("does-not-exist", &model.Config{})
+
+		assert.False(t, ok)
+		assert.Nil(t, engine)
+	})
+}
+
+// stubSearchEngine is a minimal SearchEngine used only to exercise the registry; the mocks
+// package already covers a fully generated mock of this interface.
+type stubSearchEngine struct {
+	SearchEngine
+}